@@ -0,0 +1,53 @@
+package configlet
+
+// init registers the checks built into configlet.
+func init() {
+	RegisterCheck(Checker{
+		ID:          checkInvalidConfig,
+		Title:       "config.json must be valid JSON",
+		Description: "Reports an error if config.json is missing or fails to parse.",
+		Run:         Track.ValidConfig,
+	})
+	RegisterCheck(Checker{
+		ID:          checkMissingProblem,
+		Title:       "every configured exercise must have a directory",
+		Description: "Reports exercises listed in config.json that have no corresponding directory under exercises/.",
+		Run:         Track.MissingProblems,
+	})
+	RegisterCheck(Checker{
+		ID:          checkUnconfiguredProblem,
+		Title:       "every exercise directory must be configured",
+		Description: "Reports exercise directories that aren't mentioned anywhere in config.json.",
+		Run:         Track.UnconfiguredProblems,
+	})
+	RegisterCheck(Checker{
+		ID:          checkMissingExample,
+		Title:       "every exercise must have an example solution",
+		Description: "Reports exercises whose directory has no file matching the configured solution_pattern.",
+		Run:         Track.ProblemsLackingExample,
+	})
+	RegisterCheck(Checker{
+		ID:          checkForegoneViolation,
+		Title:       "foregone exercises must not have a directory",
+		Description: "Reports exercises listed as foregone that nonetheless have an implementation directory.",
+		Run:         Track.ForegoneViolations,
+	})
+	RegisterCheck(Checker{
+		ID:          checkDuplicateSlug,
+		Title:       "a slug must not appear in multiple config categories",
+		Description: "Reports slugs that appear in more than one of exercises, deprecated, and foregone.",
+		Run:         Track.DuplicateSlugs,
+	})
+	RegisterCheck(Checker{
+		ID:          checkDuplicateUUID,
+		Title:       "an exercise uuid must be unique",
+		Description: "Reports exercises that share a uuid with another exercise.",
+		Run:         Track.DuplicateUUIDs,
+	})
+	RegisterCheck(Checker{
+		ID:          checkUnknownTopic,
+		Title:       "an exercise's topics must be in the track's topic vocabulary",
+		Description: "Reports topics not present in the track's topics.json/topics.txt file or CONFIGLET_TOPICS_URL vocabulary.",
+		Run:         Track.UnknownTopics,
+	})
+}
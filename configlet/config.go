@@ -1,6 +1,7 @@
 package configlet
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -9,15 +10,27 @@ import (
 // Config is an Exercism track configuration.
 type Config struct {
 	path            string
-	Active          bool `json:"active"`
-	Deprecated      []string
-	Exercises       []Exercise `json:"exercises"`
-	Foregone        []string   `json:"foregone"`
-	IgnorePattern   string     `json:"ignore_pattern"`
-	Language        string     `json:"language"`
-	Repository      string     `json:"repository"`
-	Slug            string     `json:"slug"`
-	SolutionPattern string     `json:"solution_pattern"`
+	raw             []byte
+	Active          bool          `json:"active"`
+	Deprecated      []string      `json:"deprecated"`
+	Exercises       []Exercise    `json:"exercises"`
+	Foregone        []string      `json:"foregone"`
+	Ignores         []IgnoreEntry `json:"ignores,omitempty"`
+	IgnorePattern   string        `json:"ignore_pattern"`
+	Language        string        `json:"language"`
+	Repository      string        `json:"repository"`
+	Slug            string        `json:"slug"`
+	SolutionPattern string        `json:"solution_pattern"`
+}
+
+// IgnoreEntry suppresses a Problem for a documented reason. It's declared
+// inline in config.json's "ignores" list, e.g.
+//
+//	{"check": "CFG005", "slug": "hello-world", "reason": "intentional"}
+type IgnoreEntry struct {
+	Check  string `json:"check"`
+	Slug   string `json:"slug"`
+	Reason string `json:"reason"`
 }
 
 // Exercise configures metadata about an implemented exercise.
@@ -36,15 +49,17 @@ type Exercise struct {
 // Load loads an Exercism track configuration.
 func Load(file string) (Config, error) {
 	c := NewConfig()
+	c.path = file
 
-	bytes, err := ioutil.ReadFile(file)
+	raw, err := ioutil.ReadFile(file)
 	if err != nil {
 		return c, err
 	}
-	err = json.Unmarshal(bytes, &c)
+	err = json.Unmarshal(raw, &c)
 	if err != nil {
 		return c, fmt.Errorf("Unable to parse config: %s -- %s", file, err.Error())
 	}
+	c.raw = raw
 
 	return c, nil
 }
@@ -68,6 +83,40 @@ func (c Config) Slugs() []string {
 	return slugs
 }
 
+// Position locates the first occurrence of needle, quoted as a JSON string,
+// inside the raw config.json source that produced c. When needle cannot be
+// found, or c was never loaded from disk, it returns a Position naming only
+// the file, with Line and Column left at 0.
+func (c Config) Position(needle string) Position {
+	pos := Position{Path: c.path}
+	if c.raw == nil {
+		return pos
+	}
+
+	idx := bytes.Index(c.raw, []byte(`"`+needle+`"`))
+	if idx < 0 {
+		return pos
+	}
+
+	pos.Line, pos.Column = lineColumn(c.raw, idx)
+	return pos
+}
+
+// lineColumn converts a byte offset into raw into a 1-indexed line and
+// column, the way a text editor would report it.
+func lineColumn(raw []byte, offset int) (line, column int) {
+	line, column = 1, 1
+	for i := 0; i < offset && i < len(raw); i++ {
+		if raw[i] == '\n' {
+			line++
+			column = 1
+			continue
+		}
+		column++
+	}
+	return line, column
+}
+
 func uniq(items []string) []string {
 	uniques := map[string]bool{}
 	for _, item := range items {
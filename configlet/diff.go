@@ -0,0 +1,126 @@
+package configlet
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+type diffOpKind int
+
+const (
+	diffEqual diffOpKind = iota
+	diffDelete
+	diffInsert
+)
+
+type diffOp struct {
+	kind diffOpKind
+	text string
+}
+
+// diffLines computes a minimal edit script turning a into b, line by line,
+// via a straightforward longest-common-subsequence search. It's quadratic
+// in the number of lines, which is fine for a file the size of config.json.
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{kind: diffEqual, text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{kind: diffDelete, text: a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{kind: diffInsert, text: b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{kind: diffDelete, text: a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{kind: diffInsert, text: b[j]})
+	}
+	return ops
+}
+
+// diffContextLines is how many unchanged lines are kept around each hunk of
+// changes, mirroring diff(1)'s default.
+const diffContextLines = 3
+
+// UnifiedDiff renders a unified-style diff between original and fixed,
+// labeling the two sides from and to. It returns "" when original and
+// fixed are identical.
+func UnifiedDiff(from, to string, original, fixed []byte) string {
+	a := strings.Split(strings.TrimSuffix(string(original), "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(string(fixed), "\n"), "\n")
+	ops := diffLines(a, b)
+
+	var buf bytes.Buffer
+	wroteHeader := false
+
+	i := 0
+	for i < len(ops) {
+		if ops[i].kind == diffEqual {
+			i++
+			continue
+		}
+
+		start := i
+		for start > 0 && i-start < diffContextLines && ops[start-1].kind == diffEqual {
+			start--
+		}
+		end := i
+		for end < len(ops) && ops[end].kind != diffEqual {
+			end++
+		}
+		stop := end
+		for stop < len(ops) && stop-end < diffContextLines && ops[stop].kind == diffEqual {
+			stop++
+		}
+
+		if !wroteHeader {
+			fmt.Fprintf(&buf, "--- %s\n", from)
+			fmt.Fprintf(&buf, "+++ %s\n", to)
+			wroteHeader = true
+		}
+
+		buf.WriteString("@@\n")
+		for _, op := range ops[start:stop] {
+			switch op.kind {
+			case diffEqual:
+				fmt.Fprintf(&buf, " %s\n", op.text)
+			case diffDelete:
+				fmt.Fprintf(&buf, "-%s\n", op.text)
+			case diffInsert:
+				fmt.Fprintf(&buf, "+%s\n", op.text)
+			}
+		}
+
+		i = stop
+	}
+
+	return buf.String()
+}
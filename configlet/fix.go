@@ -0,0 +1,148 @@
+package configlet
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Fix computes the auto-fixed form of t's config.json without writing
+// anything to disk: it assigns a generated UUID to any Exercise missing
+// one, drops deprecated/foregone entries that duplicate an active
+// exercise, and sorts the deprecated/foregone lists. The caller compares
+// original against fixed to decide whether a fix is needed.
+//
+// Fix only rewrites the "exercises", "deprecated", and "foregone" keys; it
+// keeps the value of every other key in config.json, so keys configlet
+// doesn't model aren't lost and keys that were never present aren't
+// introduced with zero-value defaults. The output is re-encoded with
+// two-space indentation and its object keys sorted.
+func (t Track) Fix() (original, fixed []byte, err error) {
+	c, err := t.Config()
+	if err != nil {
+		return nil, nil, err
+	}
+	original = c.raw
+
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(c.raw, &raw); err != nil {
+		return nil, nil, err
+	}
+
+	active := make(map[string]bool, len(c.Exercises))
+	for _, ex := range c.Exercises {
+		active[ex.Slug] = true
+	}
+
+	if exercisesRaw, present := raw["exercises"]; present {
+		fixedExercises, err := fixExerciseUUIDs(exercisesRaw)
+		if err != nil {
+			return nil, nil, err
+		}
+		raw["exercises"] = fixedExercises
+	}
+
+	if err := setFixedKey(raw, "deprecated", dedupeAgainstActive(c.Deprecated, active)); err != nil {
+		return nil, nil, err
+	}
+	if err := setFixedKey(raw, "foregone", dedupeAgainstActive(c.Foregone, active)); err != nil {
+		return nil, nil, err
+	}
+
+	fixed, err = json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+	fixed = append(fixed, '\n')
+
+	return original, fixed, nil
+}
+
+// fixExerciseUUIDs assigns a generated uuid to any exercise missing one,
+// without disturbing any other field of the exercise. Exercises round-trip
+// as maps of raw JSON rather than the Exercise struct, so fields configlet
+// doesn't model (Exercism v3's "prerequisites", "practices", "status", a
+// track's own custom fields, and so on) survive untouched.
+func fixExerciseUUIDs(raw json.RawMessage) (json.RawMessage, error) {
+	var exercises []map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &exercises); err != nil {
+		return nil, err
+	}
+
+	for _, ex := range exercises {
+		if hasUUID(ex) {
+			continue
+		}
+
+		uuid, err := newUUID()
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := json.Marshal(uuid)
+		if err != nil {
+			return nil, err
+		}
+		ex["uuid"] = encoded
+	}
+
+	return json.Marshal(exercises)
+}
+
+// hasUUID reports whether ex already has a non-empty "uuid" field.
+func hasUUID(ex map[string]json.RawMessage) bool {
+	uuidRaw, present := ex["uuid"]
+	if !present {
+		return false
+	}
+
+	var uuid string
+	if err := json.Unmarshal(uuidRaw, &uuid); err != nil {
+		return false
+	}
+	return uuid != ""
+}
+
+// setFixedKey replaces raw[key] with value's JSON encoding, but only if key
+// was already present in raw: a key config.json never had stays absent
+// from the fixed output instead of appearing with a zero-value default.
+func setFixedKey(raw map[string]json.RawMessage, key string, value interface{}) error {
+	if _, present := raw[key]; !present {
+		return nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	raw[key] = encoded
+	return nil
+}
+
+// dedupeAgainstActive drops any slug in slugs that names an active
+// exercise or repeats an earlier entry, then returns the rest sorted.
+func dedupeAgainstActive(slugs []string, active map[string]bool) []string {
+	seen := make(map[string]bool, len(slugs))
+	out := make([]string, 0, len(slugs))
+	for _, slug := range slugs {
+		if active[slug] || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		out = append(out, slug)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// newUUID generates a random (version 4) UUID, in the same format as the
+// UUIDs Exercism tracks already use for Exercise.UUID.
+func newUUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
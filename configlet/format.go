@@ -0,0 +1,189 @@
+package configlet
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// OutputFormat selects how Problems are rendered for a human or a
+// downstream tool to consume.
+type OutputFormat string
+
+// The output formats configlet supports.
+const (
+	FormatText  OutputFormat = "text"
+	FormatJSON  OutputFormat = "json"
+	FormatSARIF OutputFormat = "sarif"
+)
+
+// Format renders problems in the given format. An empty format renders as
+// FormatText.
+func Format(format OutputFormat, problems []Problem) (string, error) {
+	switch format {
+	case FormatText, "":
+		return formatText(problems), nil
+	case FormatJSON:
+		return formatJSON(problems)
+	case FormatSARIF:
+		return formatSARIF(problems)
+	default:
+		return "", fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// formatText renders problems the way configlet has always printed them:
+// one human-readable line per Problem.
+func formatText(problems []Problem) string {
+	var buf bytes.Buffer
+	for _, p := range problems {
+		if pos := p.Position.String(); pos != "" {
+			fmt.Fprintf(&buf, "-> [%s] %s (%s)\n", p.CheckID, p.Message, pos)
+		} else {
+			fmt.Fprintf(&buf, "-> [%s] %s\n", p.CheckID, p.Message)
+		}
+	}
+	return buf.String()
+}
+
+// jsonProblem is the wire shape of a Problem in FormatJSON output.
+type jsonProblem struct {
+	ID       string `json:"id"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	Path     string `json:"path,omitempty"`
+	Slug     string `json:"slug,omitempty"`
+}
+
+// formatJSON renders problems as newline-delimited JSON, one object per
+// Problem, so callers can scan or aggregate results across tracks without
+// buffering a whole array.
+func formatJSON(problems []Problem) (string, error) {
+	var buf bytes.Buffer
+	for _, p := range problems {
+		line, err := json.Marshal(jsonProblem{
+			ID:       p.CheckID,
+			Severity: p.Severity.String(),
+			Message:  p.Message,
+			Path:     p.Position.Path,
+			Slug:     p.Slug,
+		})
+		if err != nil {
+			return "", err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return buf.String(), nil
+}
+
+// The following types are a minimal SARIF 2.1.0 document: a single run,
+// a driver listing the rules that fired, and a result per Problem. See
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/ for the full schema.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// formatSARIF renders problems as a SARIF 2.1.0 log with a single run, so
+// configlet's output can feed GitHub code scanning and similar CI
+// dashboards.
+func formatSARIF(problems []Problem) (string, error) {
+	run := sarifRun{Tool: sarifTool{Driver: sarifDriver{Name: "configlet"}}}
+
+	seenRules := map[string]bool{}
+	for _, p := range problems {
+		if !seenRules[p.CheckID] {
+			seenRules[p.CheckID] = true
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: p.CheckID})
+		}
+
+		result := sarifResult{
+			RuleID:  p.CheckID,
+			Level:   sarifLevel(p.Severity),
+			Message: sarifMessage{Text: p.Message},
+		}
+		if p.Position.Path != "" {
+			loc := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: p.Position.Path}}
+			if p.Position.Line > 0 {
+				loc.Region = &sarifRegion{StartLine: p.Position.Line, StartColumn: p.Position.Column}
+			}
+			result.Locations = []sarifLocation{{PhysicalLocation: loc}}
+		}
+		run.Results = append(run.Results, result)
+	}
+
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+
+	b, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// sarifLevel maps a Severity to the SARIF result level vocabulary.
+func sarifLevel(s Severity) string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "note"
+	default:
+		return "none"
+	}
+}
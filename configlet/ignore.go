@@ -0,0 +1,170 @@
+package configlet
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// CheckUnusedIgnore is the check ID reported against an ignore directive
+// that never matched a Problem, the way honnef.co/go/tools flags stale
+// //lint:ignore comments.
+const CheckUnusedIgnore = "CFG-unused-ignore"
+
+// matchCheckID reports whether pattern, a filepath.Match glob, matches
+// checkID either in full ("CFG005-duplicate-slug") or by the code before
+// its first "-" ("CFG005").
+func matchCheckID(pattern, checkID string) bool {
+	if ok, _ := filepath.Match(pattern, checkID); ok {
+		return true
+	}
+	if idx := strings.Index(checkID, "-"); idx >= 0 {
+		ok, _ := filepath.Match(pattern, checkID[:idx])
+		return ok
+	}
+	return false
+}
+
+// Ignore suppresses Problems that match it.
+type Ignore interface {
+	// Match reports whether p should be suppressed by this Ignore.
+	Match(p Problem) bool
+	// String renders the Ignore the way it was written, for reporting
+	// unused ignore directives.
+	String() string
+}
+
+// CheckIgnore suppresses every Problem raised by checks whose ID matches
+// Check, a filepath.Match glob pattern.
+type CheckIgnore struct {
+	Check string
+}
+
+// Match implements Ignore.
+func (i CheckIgnore) Match(p Problem) bool {
+	return matchCheckID(i.Check, p.CheckID)
+}
+
+// String implements Ignore.
+func (i CheckIgnore) String() string {
+	return i.Check
+}
+
+// SlugIgnore suppresses Problems raised by a matching check against a
+// specific exercise slug.
+type SlugIgnore struct {
+	Check string
+	Slug  string
+}
+
+// Match implements Ignore.
+func (i SlugIgnore) Match(p Problem) bool {
+	return matchCheckID(i.Check, p.CheckID) && p.Slug == i.Slug
+}
+
+// String implements Ignore.
+func (i SlugIgnore) String() string {
+	return fmt.Sprintf("%s %s", i.Check, i.Slug)
+}
+
+// LineIgnore suppresses Problems raised by a matching check at a specific
+// line of config.json.
+type LineIgnore struct {
+	Check string
+	Line  int
+}
+
+// Match implements Ignore.
+func (i LineIgnore) Match(p Problem) bool {
+	return matchCheckID(i.Check, p.CheckID) && p.Position.Line == i.Line
+}
+
+// String implements Ignore.
+func (i LineIgnore) String() string {
+	return fmt.Sprintf("%s:%d", i.Check, i.Line)
+}
+
+// LoadIgnoreFile reads a .configletignore file, one directive per line.
+// Blank lines and lines starting with "#" are ignored. A directive is a
+// check ID pattern on its own ("CFG005"), a check ID pattern and a slug
+// ("CFG005 hello-world"), or a check ID pattern and a config.json line
+// number ("CFG005:42"). It is not an error for file to not exist.
+func LoadIgnoreFile(file string) ([]Ignore, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var ignores []Ignore
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		check := fields[0]
+
+		if idx := strings.Index(check, ":"); idx >= 0 {
+			n, err := strconv.Atoi(check[idx+1:])
+			if err != nil {
+				return nil, fmt.Errorf("invalid ignore directive %q: %s", line, err)
+			}
+			ignores = append(ignores, LineIgnore{Check: check[:idx], Line: n})
+			continue
+		}
+
+		if len(fields) > 1 {
+			ignores = append(ignores, SlugIgnore{Check: check, Slug: fields[1]})
+			continue
+		}
+
+		ignores = append(ignores, CheckIgnore{Check: check})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return ignores, nil
+}
+
+// FilterProblems removes any Problem in problems matched by an Ignore in
+// ignores, and appends a CheckUnusedIgnore Problem for every Ignore that
+// matched nothing.
+func FilterProblems(problems []Problem, ignores []Ignore) []Problem {
+	used := make([]bool, len(ignores))
+
+	kept := make([]Problem, 0, len(problems))
+	for _, p := range problems {
+		suppressed := false
+		for i, ignore := range ignores {
+			if ignore.Match(p) {
+				used[i] = true
+				suppressed = true
+			}
+		}
+		if !suppressed {
+			kept = append(kept, p)
+		}
+	}
+
+	for i, ignore := range ignores {
+		if !used[i] {
+			kept = append(kept, Problem{
+				CheckID:  CheckUnusedIgnore,
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("ignore directive %q never matched a problem", ignore),
+			})
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,88 @@
+package configlet
+
+import "fmt"
+
+// Severity indicates how serious a Problem is.
+type Severity int
+
+// The severities a Problem can have, ordered from most to least serious.
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+	SeverityInfo
+)
+
+// String implements fmt.Stringer.
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "info"
+	default:
+		return "unknown"
+	}
+}
+
+// Position locates a Problem, either inside config.json or, when a check is
+// about an exercise's files rather than its configuration, at the
+// exercise's directory.
+type Position struct {
+	Path   string
+	Line   int
+	Column int
+}
+
+// String implements fmt.Stringer. Line is omitted when it is unknown, which
+// is the case whenever Position points at a directory rather than a
+// specific spot inside config.json.
+func (p Position) String() string {
+	if p.Line == 0 {
+		return p.Path
+	}
+	return fmt.Sprintf("%s:%d:%d", p.Path, p.Line, p.Column)
+}
+
+// Problem is a single diagnostic raised by a Checker.
+type Problem struct {
+	// CheckID is the stable identifier of the Checker that raised this
+	// Problem, e.g. "CFG005-duplicate-slug".
+	CheckID string
+	// Severity is how serious the Problem is.
+	Severity Severity
+	// Message is a human readable description of the Problem.
+	Message string
+	// Position is where the Problem was found.
+	Position Position
+	// Slug is the exercise this Problem concerns, when it concerns a
+	// single exercise. It is empty for Problems about the track as a
+	// whole, such as an invalid config.json.
+	Slug string
+}
+
+// Checker is a single diagnostic check that can be run against a Track.
+type Checker struct {
+	// ID is the stable identifier of the check, e.g. "CFG005-duplicate-slug".
+	ID string
+	// Title is a short, human readable summary of what the check looks for.
+	Title string
+	// Description is a longer explanation of what the check looks for and
+	// why, shown by -list-checks.
+	Description string
+	// Run executes the check against t, returning any Problems found.
+	Run func(t Track) []Problem
+}
+
+// errorProblem wraps an unexpected error (e.g. a failed directory read) as
+// a Problem, so that Checkers never need to return an error alongside
+// their Problems.
+func errorProblem(checkID, path string, err error) Problem {
+	return Problem{
+		CheckID:  checkID,
+		Severity: SeverityError,
+		Message:  err.Error(),
+		Position: Position{Path: path},
+	}
+}
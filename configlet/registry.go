@@ -0,0 +1,19 @@
+package configlet
+
+// registry holds every Checker made available to configlet, in the order
+// they were registered.
+var registry []Checker
+
+// RegisterCheck adds c to the set of checks configlet runs. Track-specific
+// or third-party checks can call this from an init function to participate
+// without editing configlet itself.
+func RegisterCheck(c Checker) {
+	registry = append(registry, c)
+}
+
+// Checks returns every registered Checker, in registration order.
+func Checks() []Checker {
+	out := make([]Checker, len(registry))
+	copy(out, registry)
+	return out
+}
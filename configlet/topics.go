@@ -0,0 +1,95 @@
+package configlet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// topicsEnvVar, when set, overrides a track's topic vocabulary with one
+// fetched from a URL, for tracks that keep their canonical list elsewhere.
+const topicsEnvVar = "CONFIGLET_TOPICS_URL"
+
+// topics loads the canonical topic vocabulary for t: CONFIGLET_TOPICS_URL
+// takes precedence, falling back to a topics.json or topics.txt file at
+// the track root. It returns a nil map, not an error, when no vocabulary
+// is configured, which tells UnknownTopics to skip the track.
+func (t Track) topics() (map[string]bool, error) {
+	if url := os.Getenv(topicsEnvVar); url != "" {
+		return fetchTopics(url)
+	}
+
+	for _, name := range []string{"topics.json", "topics.txt"} {
+		path := filepath.Join(t.path, name)
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		return parseTopics(raw)
+	}
+
+	return nil, nil
+}
+
+func fetchTopics(url string) (map[string]bool, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching topics from %s: unexpected status %s", url, resp.Status)
+	}
+
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return parseTopics(raw)
+}
+
+// parseTopics reads raw as a JSON array of strings when it looks like one
+// (starts with '['), otherwise as one topic per line, skipping blank lines
+// and lines starting with "#". Sniffing the content rather than trusting a
+// file extension or URL avoids misparsing something like "topics.json?ref=x".
+func parseTopics(raw []byte) (map[string]bool, error) {
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '[' {
+		var list []string
+		if err := json.Unmarshal(trimmed, &list); err != nil {
+			return nil, err
+		}
+		return topicSet(list), nil
+	}
+
+	topics := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		topics[line] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
+
+func topicSet(list []string) map[string]bool {
+	topics := make(map[string]bool, len(list))
+	for _, topic := range list {
+		topics[topic] = true
+	}
+	return topics
+}
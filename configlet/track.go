@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strings"
 )
 
 // Track is a collection of Exercism exercises in a given programming language.
@@ -16,6 +17,19 @@ type Track struct {
 	dirs map[string]string
 }
 
+// Check IDs for the Checkers implemented directly against Track. They are
+// referenced both when building Problems and when registering Checkers.
+const (
+	checkInvalidConfig       = "CFG000-invalid-config"
+	checkMissingProblem      = "CFG001-missing-problem"
+	checkUnconfiguredProblem = "CFG002-unconfigured-problem"
+	checkMissingExample      = "CFG003-missing-example"
+	checkForegoneViolation   = "CFG004-foregone-violation"
+	checkDuplicateSlug       = "CFG005-duplicate-slug"
+	checkDuplicateUUID       = "CFG006-duplicate-uuid"
+	checkUnknownTopic        = "CFG007-unknown-topic"
+)
+
 // NewTrack is an exercism language track that lives at path.
 // It uses the config.json in the root of the track to figure
 // out which exercises a track contains.
@@ -43,6 +57,11 @@ func NewTrack(path string) (Track, error) {
 	return t, nil
 }
 
+// Root is the path where the track lives on disk.
+func (t Track) Root() string {
+	return t.path
+}
+
 // Config loads a track's configuration.
 func (t Track) Config() (Config, error) {
 	c, err := Load(t.configFile())
@@ -52,17 +71,43 @@ func (t Track) Config() (Config, error) {
 	return c, nil
 }
 
-// HasValidConfig lints the JSON file.
-func (t Track) HasValidConfig() bool {
+// Ignores collects every ignore directive that applies to t: those listed
+// in .configletignore at the track root, plus those declared inline in
+// config.json's "ignores" block.
+func (t Track) Ignores() ([]Ignore, error) {
+	ignores, err := LoadIgnoreFile(filepath.Join(t.path, ".configletignore"))
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := t.Config()
+	if err != nil {
+		return ignores, err
+	}
+
+	for _, entry := range c.Ignores {
+		if entry.Slug == "" {
+			ignores = append(ignores, CheckIgnore{Check: entry.Check})
+			continue
+		}
+		ignores = append(ignores, SlugIgnore{Check: entry.Check, Slug: entry.Slug})
+	}
+
+	return ignores, nil
+}
 
+// ValidConfig checks that config.json exists and parses successfully.
+func (t Track) ValidConfig() []Problem {
 	c, err := t.Config()
-	// re-marshall json with 2 space indent
-	b, err := json.MarshalIndent(c, "", "  ")
 	if err != nil {
-		fmt.Println("error:", err)
+		return []Problem{errorProblem(checkInvalidConfig, t.configFile(), err)}
 	}
-	fmt.Printf("b = %+v\n", string(b))
-	return err == nil
+
+	if _, err := json.MarshalIndent(c, "", "  "); err != nil {
+		return []Problem{errorProblem(checkInvalidConfig, t.configFile(), err)}
+	}
+
+	return nil
 }
 
 // Problems lists all the problem specifications that a track has implemented exercises for.
@@ -133,51 +178,59 @@ func (t Track) Dirs() (map[string]struct{}, error) {
 // MissingProblems identify problems lacking an implementation.
 // This will complain if the problem slug is listed in the configuration,
 // but there is no corresponding directory for it.
-func (t Track) MissingProblems() ([]string, error) {
+func (t Track) MissingProblems() []Problem {
 	dirs, err := t.Dirs()
 	if err != nil {
-		return []string{}, err
+		return []Problem{errorProblem(checkMissingProblem, t.configFile(), err)}
 	}
 
 	problems, err := t.Problems()
 	if err != nil {
-		return []string{}, err
+		return []Problem{errorProblem(checkMissingProblem, t.configFile(), err)}
 	}
 
-	omissions := make([]string, 0, len(problems))
-
+	var issues []Problem
 	for problem := range problems {
-		_, present := dirs[problem]
-		if !present {
-			omissions = append(omissions, problem)
+		if _, present := dirs[problem]; !present {
+			issues = append(issues, Problem{
+				CheckID:  checkMissingProblem,
+				Severity: SeverityError,
+				Message:  fmt.Sprintf("no directory found for %q", problem),
+				Position: t.configPosition(problem),
+				Slug:     problem,
+			})
 		}
 	}
-	return omissions, nil
+	return issues
 }
 
 // UnconfiguredProblems identifies unlisted implementations.
 // This will complain if a directory exists, but is not mentioned
 // anywhere in the config file.
-func (t Track) UnconfiguredProblems() ([]string, error) {
+func (t Track) UnconfiguredProblems() []Problem {
 	dirs, err := t.Dirs()
 	if err != nil {
-		return []string{}, err
+		return []Problem{errorProblem(checkUnconfiguredProblem, t.configFile(), err)}
 	}
 
 	slugs, err := t.Slugs()
 	if err != nil {
-		return []string{}, err
+		return []Problem{errorProblem(checkUnconfiguredProblem, t.configFile(), err)}
 	}
 
-	omissions := make([]string, 0, len(slugs))
-
+	var issues []Problem
 	for dir := range dirs {
-		_, present := slugs[dir]
-		if !present {
-			omissions = append(omissions, dir)
+		if _, present := slugs[dir]; !present {
+			issues = append(issues, Problem{
+				CheckID:  checkUnconfiguredProblem,
+				Severity: SeverityError,
+				Message:  fmt.Sprintf("config.json does not include %q", dir),
+				Position: Position{Path: filepath.Join(t.path, "exercises", dir)},
+				Slug:     dir,
+			})
 		}
 	}
-	return omissions, nil
+	return issues
 }
 
 // ProblemsLackingExample identifies implementations without a solution.
@@ -186,14 +239,13 @@ func (t Track) UnconfiguredProblems() ([]string, error) {
 // any file that is in a path not named /[Ee]xample/ will be served by the API,
 // showing the user a possible solution before they have solved the problem
 // themselves.
-func (t Track) ProblemsLackingExample() ([]string, error) {
+func (t Track) ProblemsLackingExample() []Problem {
 	c, err := t.Config()
 	if err != nil {
-		return nil, err
+		return []Problem{errorProblem(checkMissingExample, t.configFile(), err)}
 	}
 
-	var issues []string
-
+	var issues []Problem
 	for _, problem := range c.Slugs() {
 		path := t.dirs[problem]
 		if path == "" {
@@ -202,42 +254,53 @@ func (t Track) ProblemsLackingExample() ([]string, error) {
 
 		files, err := findAllFiles(path)
 		if err != nil {
-			return issues, err
+			return append(issues, errorProblem(checkMissingExample, path, err))
 		}
 		found, err := t.hasExampleFile(files)
+		if err != nil {
+			return append(issues, errorProblem(checkMissingExample, path, err))
+		}
 		if !found {
-			issues = append(issues, problem)
+			issues = append(issues, Problem{
+				CheckID:  checkMissingExample,
+				Severity: SeverityError,
+				Message:  fmt.Sprintf("missing example solution in %q", problem),
+				Position: Position{Path: path},
+				Slug:     problem,
+			})
 		}
 	}
 
-	return issues, nil
+	return issues
 }
 
 // ForegoneViolations indentifies implementations that should not be included.
 // This could be because the problem is too trivial, ridiculously non-trivial,
 // or simply uninteresting.
-func (t Track) ForegoneViolations() ([]string, error) {
-	problems := []string{}
-
+func (t Track) ForegoneViolations() []Problem {
 	c, err := t.Config()
 	if err != nil {
-		return problems, err
+		return []Problem{errorProblem(checkForegoneViolation, t.configFile(), err)}
 	}
 
 	dirs, err := t.Dirs()
 	if err != nil {
-		return problems, err
+		return []Problem{errorProblem(checkForegoneViolation, t.configFile(), err)}
 	}
 
-	violations := make([]string, 0, len(dirs))
-
+	var issues []Problem
 	for _, problem := range c.Foregone {
-		_, present := dirs[problem]
-		if present {
-			violations = append(violations, problem)
+		if _, present := dirs[problem]; present {
+			issues = append(issues, Problem{
+				CheckID:  checkForegoneViolation,
+				Severity: SeverityError,
+				Message:  fmt.Sprintf("%q should not be implemented", problem),
+				Position: t.configPosition(problem),
+				Slug:     problem,
+			})
 		}
 	}
-	return violations, nil
+	return issues
 }
 
 // DuplicateSlugs detects slugs in multiple config categories.
@@ -245,12 +308,12 @@ func (t Track) ForegoneViolations() ([]string, error) {
 // we're just not serving it in the default response.
 // If a slug is foregone, it means that we've chosen not to implement it,
 // and it should not have a directory.
-func (t Track) DuplicateSlugs() ([]string, error) {
+func (t Track) DuplicateSlugs() []Problem {
 	counts := make(map[string]int)
 
 	c, err := t.Config()
 	if err != nil {
-		return []string{}, err
+		return []Problem{errorProblem(checkDuplicateSlug, t.configFile(), err)}
 	}
 
 	for _, slug := range c.Slugs() {
@@ -265,7 +328,7 @@ func (t Track) DuplicateSlugs() ([]string, error) {
 		counts[slug] = counts[slug] + 1
 	}
 
-	dupes := make([]string, 0, len(counts))
+	var dupes []string
 	for slug, count := range counts {
 		if count > 1 {
 			dupes = append(dupes, slug)
@@ -273,13 +336,122 @@ func (t Track) DuplicateSlugs() ([]string, error) {
 	}
 	sort.Strings(dupes)
 
-	return dupes, nil
+	issues := make([]Problem, 0, len(dupes))
+	for _, slug := range dupes {
+		issues = append(issues, Problem{
+			CheckID:  checkDuplicateSlug,
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("%q found in multiple categories", slug),
+			Position: t.configPosition(slug),
+			Slug:     slug,
+		})
+	}
+	return issues
+}
+
+// DuplicateUUIDs detects Exercise entries that share a UUID.
+// Exercism's API identifies exercises by UUID, so a collision would make
+// it ambiguous which exercise a client is actually being served.
+func (t Track) DuplicateUUIDs() []Problem {
+	c, err := t.Config()
+	if err != nil {
+		return []Problem{errorProblem(checkDuplicateUUID, t.configFile(), err)}
+	}
+
+	slugsByUUID := make(map[string][]string)
+	for _, ex := range c.Exercises {
+		if ex.UUID == "" {
+			continue
+		}
+		slugsByUUID[ex.UUID] = append(slugsByUUID[ex.UUID], ex.Slug)
+	}
+
+	var issues []Problem
+	for uuid, slugs := range slugsByUUID {
+		if len(slugs) < 2 {
+			continue
+		}
+		sort.Strings(slugs)
+
+		for _, slug := range slugs {
+			others := without(slugs, slug)
+			msg := fmt.Sprintf("%s shares uuid %s with %s", slug, uuid, strings.Join(others, ", "))
+			issues = append(issues, Problem{
+				CheckID:  checkDuplicateUUID,
+				Severity: SeverityError,
+				Message:  msg,
+				Position: t.configPosition(uuid),
+				Slug:     slug,
+			})
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool { return issues[i].Slug < issues[j].Slug })
+	return issues
+}
+
+// without returns a copy of items with exclude removed, preserving order.
+func without(items []string, exclude string) []string {
+	out := make([]string, 0, len(items)-1)
+	for _, item := range items {
+		if item == exclude {
+			continue
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// UnknownTopics validates each Exercise's Topics against the track's
+// canonical topic vocabulary (see Track.topics). If the track has no
+// vocabulary configured, UnknownTopics reports nothing.
+func (t Track) UnknownTopics() []Problem {
+	known, err := t.topics()
+	if err != nil {
+		return []Problem{errorProblem(checkUnknownTopic, t.configFile(), err)}
+	}
+	if known == nil {
+		return nil
+	}
+
+	c, err := t.Config()
+	if err != nil {
+		return []Problem{errorProblem(checkUnknownTopic, t.configFile(), err)}
+	}
+
+	var issues []Problem
+	for _, ex := range c.Exercises {
+		for _, topic := range ex.Topics {
+			if known[topic] {
+				continue
+			}
+			issues = append(issues, Problem{
+				CheckID:  checkUnknownTopic,
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("%q is not a known topic (exercise %q)", topic, ex.Slug),
+				Position: t.configPosition(topic),
+				Slug:     ex.Slug,
+			})
+		}
+	}
+	return issues
 }
 
 func (t Track) configFile() string {
 	return fmt.Sprintf("%s/config.json", t.path)
 }
 
+// configPosition locates needle inside config.json, falling back to a
+// Position naming only the file, with no line, if the config cannot be
+// loaded or needle is not found.
+func (t Track) configPosition(needle string) Position {
+	c, err := t.Config()
+	if err != nil {
+		return Position{Path: t.configFile()}
+	}
+	return c.Position(needle)
+}
+
 func (t Track) hasExampleFile(files []string) (bool, error) {
 	c, err := t.Config()
 	if err != nil {
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/exercism/configlet/configlet"
+)
+
+// runFix implements the "configlet fix" subcommand: it applies configlet's
+// safe auto-fixes to a track's config.json, printing a diff or checking
+// for CI instead of writing when asked.
+func runFix(args []string) {
+	fs := flag.NewFlagSet("configlet fix", flag.ExitOnError)
+	diff := fs.Bool("diff", false, "print the unified diff of the fix without writing it")
+	check := fs.Bool("check", false, "exit non-zero if config.json would be changed, without writing it")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fmt.Println("Usage: configlet fix [-diff] [-check] path/to/problems/repository")
+		os.Exit(1)
+	}
+	path := fs.Arg(0)
+
+	track, err := configlet.NewTrack(path)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	original, fixed, err := track.Fix()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	configFile := fmt.Sprintf("%s/config.json", path)
+
+	if string(original) == string(fixed) {
+		if !*check {
+			fmt.Println("config.json already matches configlet fix")
+		}
+		return
+	}
+
+	switch {
+	case *check:
+		fmt.Printf("-> %s would be rewritten by configlet fix\n", configFile)
+		os.Exit(1)
+	case *diff:
+		fmt.Print(configlet.UnifiedDiff(configFile, configFile, original, fixed))
+	default:
+		if err := ioutil.WriteFile(configFile, fixed, 0644); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		fmt.Printf("-> rewrote %s\n", configFile)
+	}
+}
@@ -1,78 +1,143 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/exercism/configlet/configlet"
 )
 
-// Check identifies configuration problems.
-type Check func() ([]string, error)
-
-// ConfigError defines the error message for a Check.
-type ConfigError struct {
-	check Check
-	msg   string
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "fix" {
+		runFix(os.Args[2:])
+		return
+	}
+	runLint(os.Args[1:])
 }
 
-func main() {
-	if len(os.Args) != 2 {
-		fmt.Println("Usage: configlet path/to/problems/repository")
+func runLint(args []string) {
+	fs := flag.NewFlagSet("configlet", flag.ExitOnError)
+	checksFlag := fs.String("checks", "", "comma-separated check IDs/glob patterns to run, e.g. CFG00*,!CFG005 (default: all)")
+	formatFlag := fs.String("format", string(configlet.FormatText), "output format: text, json, or sarif")
+	listChecks := fs.Bool("list-checks", false, "print the available checks and exit")
+	fs.Parse(args)
+
+	if *listChecks {
+		for _, check := range configlet.Checks() {
+			fmt.Printf("%s\t%s\t%s\n", check.ID, check.Title, check.Description)
+		}
+		return
+	}
+
+	if fs.NArg() != 1 {
+		fmt.Println("Usage: configlet [-checks=pattern,...] [-format=text|json|sarif] [-list-checks] path/to/problems/repository")
 		os.Exit(1)
 	}
 
-	path := os.Args[1]
-	fmt.Printf("Evaluating %s\n", path)
+	format := configlet.OutputFormat(*formatFlag)
+	path := fs.Arg(0)
 
-	track := configlet.NewTrack(path)
+	if format == configlet.FormatText {
+		fmt.Printf("Evaluating %s\n", path)
+	}
 
-	hasErrors := false
-	if !track.HasValidConfig() {
-		hasErrors = true
-		fmt.Println("-> config.json is invalid")
+	track, err := configlet.NewTrack(path)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
 	}
 
-	configErrors := []ConfigError{
-		ConfigError{
-			check: track.MissingProblems,
-			msg:   "-> No directory found for %v.\n",
-		},
-		ConfigError{
-			check: track.UnconfiguredProblems,
-			msg:   "-> config.json does not include %v.\n",
-		},
-		ConfigError{
-			check: track.ProblemsLackingExample,
-			msg:   "-> missing example solution in %v.\n",
-		},
-		ConfigError{
-			check: track.ForegoneViolations,
-			msg:   "-> %v should not be implemented.\n",
-		},
-		ConfigError{
-			check: track.DuplicateSlugs,
-			msg:   "-> %v found in multiple categories.\n",
-		},
+	selected, err := selectChecks(configlet.Checks(), *checksFlag)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
 	}
 
-	for _, configError := range configErrors {
-		result, err := configError.check()
+	var problems []configlet.Problem
+	for _, check := range selected {
+		problems = append(problems, check.Run(track)...)
+	}
 
-		if err != nil {
-			hasErrors = true
-			fmt.Errorf("-> %v", err)
-		}
+	ignores, err := track.Ignores()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	problems = configlet.FilterProblems(problems, ignores)
 
-		if len(result) > 0 {
+	hasErrors := false
+	for _, problem := range problems {
+		if problem.Severity == configlet.SeverityError {
 			hasErrors = true
-			fmt.Printf(configError.msg, result)
 		}
 	}
 
+	output, err := configlet.Format(format, problems)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	fmt.Print(output)
+
 	if hasErrors {
 		os.Exit(1)
 	}
 
-	fmt.Println("... OK")
+	if format == configlet.FormatText {
+		fmt.Println("... OK")
+	}
+}
+
+// selectChecks filters checks down to those selected by patterns, a
+// comma-separated list of check ID glob patterns, each matched against the
+// full ID or the code before its first "-". A pattern prefixed with
+// "!" disables the checks it matches; otherwise it enables them. Patterns
+// are applied in order, so later patterns override earlier ones. An empty
+// patterns string selects every check.
+func selectChecks(checks []configlet.Checker, patterns string) ([]configlet.Checker, error) {
+	if strings.TrimSpace(patterns) == "" {
+		return checks, nil
+	}
+
+	enabled := make(map[string]bool, len(checks))
+	for _, pattern := range strings.Split(patterns, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		disable := strings.HasPrefix(pattern, "!")
+		if disable {
+			pattern = pattern[1:]
+		}
+
+		matched := false
+		for _, check := range checks {
+			ok, err := filepath.Match(pattern, check.ID)
+			if err != nil {
+				return nil, fmt.Errorf("invalid check pattern %q: %s", pattern, err)
+			}
+			if idx := strings.Index(check.ID, "-"); !ok && idx >= 0 {
+				ok, _ = filepath.Match(pattern, check.ID[:idx])
+			}
+			if ok {
+				enabled[check.ID] = !disable
+				matched = true
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("check pattern %q matched no checks", pattern)
+		}
+	}
+
+	var selected []configlet.Checker
+	for _, check := range checks {
+		if enabled[check.ID] {
+			selected = append(selected, check)
+		}
+	}
+	return selected, nil
 }